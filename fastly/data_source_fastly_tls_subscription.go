@@ -0,0 +1,273 @@
+package fastly
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v10/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFastlyTLSSubscription() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyTLSSubscriptionRead,
+		Schema: map[string]*schema.Schema{
+			"certificate_authority": {
+				Type:        schema.TypeString,
+				Description: "The entity that issues and certifies the TLS certificate.",
+				Optional:    true,
+			},
+			"certificate_id": {
+				Type:        schema.TypeString,
+				Description: "The certificate ID associated with the subscription.",
+				Computed:    true,
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Description: "The common name associated with the subscription generated by Fastly TLS.",
+				Computed:    true,
+			},
+			"configuration_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the set of TLS configuration options that apply to the enabled domains on this subscription.",
+				Optional:    true,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "Timestamp (GMT) when the subscription was created.",
+				Computed:    true,
+			},
+			"domains": {
+				Type:        schema.TypeSet,
+				Description: "The domains on which to enable TLS. Used to look up a subscription covering all of the given domains.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"globalsign_email_challenges": {
+				Type:        schema.TypeSet,
+				Description: "A list of options for configuring the GlobalSign email challenge used to verify domain ownership.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorization_id": {Type: schema.TypeString, Computed: true},
+						"email_address":    {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of a specific subscription to look up.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"managed_dns_challenges": {
+				Type:        schema.TypeSet,
+				Description: "A list of options for configuring DNS to respond to ACME DNS challenge in order to verify domain ownership.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"record_name":  {Type: schema.TypeString, Computed: true},
+						"record_type":  {Type: schema.TypeString, Computed: true},
+						"record_value": {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+			"managed_http_challenges": {
+				Type:        schema.TypeSet,
+				Description: "A list of options for configuring DNS to respond to ACME HTTP challenge in order to verify domain ownership.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"record_name":   {Type: schema.TypeString, Computed: true},
+						"record_type":   {Type: schema.TypeString, Computed: true},
+						"record_values": {Type: schema.TypeSet, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					},
+				},
+			},
+			"most_recent": {
+				Type:        schema.TypeBool,
+				Description: "If `true` and multiple subscriptions match, the most recently created one is returned instead of erroring. Defaults to `false`.",
+				Optional:    true,
+				Default:     false,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "The current state of the subscription.",
+				Computed:    true,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Description: "Timestamp (GMT) when the subscription was updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceFastlyTLSSubscriptionRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	if id, ok := d.GetOk("id"); ok {
+		include := "tls_authorizations"
+		subscription, err := conn.GetTLSSubscription(&gofastly.GetTLSSubscriptionInput{ID: id.(string), Include: &include})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return dataSourceFastlyTLSSubscriptionSet(d, subscription)
+	}
+
+	var wantDomains []string
+	for _, domain := range d.Get("domains").(*schema.Set).List() {
+		wantDomains = append(wantDomains, domain.(string))
+	}
+	wantCA := d.Get("certificate_authority").(string)
+	wantConfigurationID := d.Get("configuration_id").(string)
+
+	subscriptions, err := conn.ListTLSSubscriptions(&gofastly.ListTLSSubscriptionsInput{Include: "tls_authorizations"})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var matches []*gofastly.TLSSubscription
+	for _, subscription := range subscriptions {
+		if wantCA != "" && subscription.CertificateAuthority != wantCA {
+			continue
+		}
+		if wantConfigurationID != "" && (subscription.Configuration == nil || subscription.Configuration.ID != wantConfigurationID) {
+			continue
+		}
+		if !subscriptionHasAllDomains(subscription, wantDomains) {
+			continue
+		}
+		matches = append(matches, subscription)
+	}
+
+	if len(matches) == 0 {
+		return diag.Errorf("your query returned no results for fastly_tls_subscription - please change your search criteria and try again")
+	}
+
+	if len(matches) > 1 && !d.Get("most_recent").(bool) {
+		return diag.Errorf("your query returned more than one result for fastly_tls_subscription - please use a more specific search criteria, or set most_recent = true")
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		switch {
+		case matches[i].CreatedAt == nil:
+			return false
+		case matches[j].CreatedAt == nil:
+			return true
+		default:
+			return matches[i].CreatedAt.After(*matches[j].CreatedAt)
+		}
+	})
+
+	return dataSourceFastlyTLSSubscriptionSet(d, matches[0])
+}
+
+func subscriptionHasAllDomains(subscription *gofastly.TLSSubscription, wantDomains []string) bool {
+	if len(wantDomains) == 0 {
+		return true
+	}
+
+	have := make(map[string]bool, len(subscription.Domains))
+	for _, domain := range subscription.Domains {
+		have[domain.ID] = true
+	}
+	for _, domain := range wantDomains {
+		if !have[domain] {
+			return false
+		}
+	}
+	return true
+}
+
+func dataSourceFastlyTLSSubscriptionSet(d *schema.ResourceData, subscription *gofastly.TLSSubscription) diag.Diagnostics {
+	d.SetId(subscription.ID)
+
+	var domains []string
+	for _, domain := range subscription.Domains {
+		domains = append(domains, domain.ID)
+	}
+
+	certificateID := ""
+	if len(subscription.Certificates) > 0 {
+		certificateID = subscription.Certificates[0].ID
+	}
+
+	// All four can be nil - e.g. a "pending" subscription with no domains
+	// activated yet has no configuration or common name assigned, and
+	// CreatedAt/UpdatedAt are omitted from the API response until the
+	// subscription starts processing.
+	commonName := ""
+	if subscription.CommonName != nil {
+		commonName = subscription.CommonName.ID
+	}
+	configurationID := ""
+	if subscription.Configuration != nil {
+		configurationID = subscription.Configuration.ID
+	}
+	createdAt := ""
+	if subscription.CreatedAt != nil {
+		createdAt = subscription.CreatedAt.Format(time.RFC3339)
+	}
+	updatedAt := ""
+	if subscription.UpdatedAt != nil {
+		updatedAt = subscription.UpdatedAt.Format(time.RFC3339)
+	}
+
+	var managedHTTPChallenges []map[string]any
+	var managedDNSChallenges []map[string]any
+	var globalsignEmailChallenges []map[string]any
+	for _, authorization := range subscription.Authorizations {
+		for _, challenge := range authorization.Challenges {
+			switch challenge.Type {
+			case "managed-dns":
+				if len(challenge.Values) < 1 {
+					return diag.Errorf("fastly API returned no record values for Managed DNS Challenges")
+				}
+				managedDNSChallenges = append(managedDNSChallenges, map[string]any{
+					"record_type":  challenge.RecordType,
+					"record_name":  challenge.RecordName,
+					"record_value": challenge.Values[0],
+				})
+			case "globalsign-email":
+				var emailAddress string
+				if len(challenge.Values) > 0 {
+					emailAddress = challenge.Values[0]
+				}
+				globalsignEmailChallenges = append(globalsignEmailChallenges, map[string]any{
+					"authorization_id": authorization.ID,
+					"email_address":    emailAddress,
+				})
+			default:
+				managedHTTPChallenges = append(managedHTTPChallenges, map[string]any{
+					"record_type":   challenge.RecordType,
+					"record_name":   challenge.RecordName,
+					"record_values": challenge.Values,
+				})
+			}
+		}
+	}
+
+	for key, value := range map[string]any{
+		"domains":                     domains,
+		"certificate_authority":       subscription.CertificateAuthority,
+		"certificate_id":              certificateID,
+		"common_name":                 commonName,
+		"configuration_id":            configurationID,
+		"created_at":                  createdAt,
+		"updated_at":                  updatedAt,
+		"state":                       subscription.State,
+		"managed_dns_challenges":      managedDNSChallenges,
+		"managed_http_challenges":     managedHTTPChallenges,
+		"globalsign_email_challenges": globalsignEmailChallenges,
+	} {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
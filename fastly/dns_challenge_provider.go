@@ -0,0 +1,99 @@
+package fastly
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// dnsChallengeRecord is a single ACME DNS-01 challenge record as returned by
+// Fastly for a "managed-dns" authorization.
+type dnsChallengeRecord struct {
+	RecordName  string
+	RecordType  string
+	RecordValue string
+}
+
+// dnsChallengeProvider is implemented by each pluggable DNS backend that
+// fastly_tls_subscription_dns_solver can use to publish and clean up the
+// DNS records Fastly requires to verify domain ownership.
+//
+// This mirrors the "one package per DNS backend" plugin model popularised by
+// lego (https://github.com/go-acme/lego/tree/master/providers/dns), without
+// taking the dependency directly, since Fastly hands us the already-computed
+// record name/type/value rather than an ACME token we'd need to turn into one.
+type dnsChallengeProvider interface {
+	// Present publishes the DNS record required to satisfy the challenge.
+	Present(ctx context.Context, record dnsChallengeRecord) error
+	// CleanUp removes a record previously published by Present.
+	CleanUp(ctx context.Context, record dnsChallengeRecord) error
+}
+
+// newDNSChallengeProvider builds the provider named by providerName, configured
+// from the freeform config map supplied on the dns_solver's config attribute.
+//
+// "webhook" is the only backend. There is no native Route53, Cloudflare,
+// Azure DNS or Google Cloud DNS backend: the user hosts their own endpoint
+// that writes the records to whichever DNS provider they use.
+func newDNSChallengeProvider(_ context.Context, providerName string, config map[string]string) (dnsChallengeProvider, error) {
+	switch providerName {
+	case "webhook":
+		return newWebhookDNSChallengeProvider(config)
+	default:
+		return nil, fmt.Errorf("unsupported dns_provider %q", providerName)
+	}
+}
+
+// webhookDNSChallengeProvider publishes and removes records by issuing plain
+// HTTP requests to a user-configured endpoint, rather than linking a
+// cloud-specific SDK into the provider.
+type webhookDNSChallengeProvider struct {
+	client *http.Client
+	url    string
+}
+
+func newWebhookDNSChallengeProvider(config map[string]string) (*webhookDNSChallengeProvider, error) {
+	url, ok := config["url"]
+	if !ok || url == "" {
+		return nil, fmt.Errorf("webhook dns_provider requires a %q config value", "url")
+	}
+
+	return &webhookDNSChallengeProvider{
+		client: http.DefaultClient,
+		url:    url,
+	}, nil
+}
+
+func (p *webhookDNSChallengeProvider) Present(ctx context.Context, record dnsChallengeRecord) error {
+	return p.call(ctx, http.MethodPut, record)
+}
+
+func (p *webhookDNSChallengeProvider) CleanUp(ctx context.Context, record dnsChallengeRecord) error {
+	return p.call(ctx, http.MethodDelete, record)
+}
+
+func (p *webhookDNSChallengeProvider) call(ctx context.Context, method string, record dnsChallengeRecord) error {
+	body, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook dns_provider returned status %d for %s %s", resp.StatusCode, method, p.url)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package fastly
+
+import (
+	"context"
+
+	gofastly "github.com/fastly/go-fastly/v10/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// APIClient is the Fastly client shared across all resources and data
+// sources via the provider's meta value.
+type APIClient struct {
+	conn *gofastly.Client
+}
+
+func Provider() *schema.Provider {
+	provider := &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"api_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FASTLY_API_KEY", nil),
+				Description: "The Fastly API key, usually provisioned via the Fastly control panel.",
+			},
+			"base_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FASTLY_API_URL", gofastly.DefaultEndpoint),
+				Description: "The base URL for the Fastly API, used to override when interacting with a test server.",
+			},
+		},
+		ResourcesMap: map[string]*schema.Resource{
+			"fastly_tls_globalsign_email_challenge": resourceFastlyTLSSubscriptionGlobalSignEmailChallenge(),
+			"fastly_tls_subscription":               resourceFastlyTLSSubscription(),
+			"fastly_tls_subscription_dns_solver":    resourceFastlyTLSSubscriptionDNSSolver(),
+			"fastly_tls_subscription_validation":    resourceFastlyTLSSubscriptionValidation(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"fastly_tls_subscription": dataSourceFastlyTLSSubscription(),
+		},
+	}
+
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+		return providerConfigure(ctx, d, provider)
+	}
+
+	return provider
+}
+
+func providerConfigure(_ context.Context, d *schema.ResourceData, _ *schema.Provider) (any, diag.Diagnostics) {
+	client, err := gofastly.NewClientForEndpoint(d.Get("api_key").(string), d.Get("base_url").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return &APIClient{conn: client}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -82,6 +83,31 @@ func resourceFastlyTLSSubscription() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"globalsign_email_challenges": {
+				Type:        schema.TypeSet,
+				Description: "A list of options for configuring the GlobalSign email challenge used to verify domain ownership. Only populated when `certificate_authority = \"globalsign\"`.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorization_id": {
+							Type:        schema.TypeString,
+							Description: "The ID of the TLS authorization the email challenge applies to. Pass this to `fastly_tls_globalsign_email_challenge` to request the confirmation email once the recipient address has been chosen.",
+							Computed:    true,
+						},
+						"email_address": {
+							Type:        schema.TypeString,
+							Description: "The approver email address the confirmation email was sent to.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"max_domains_per_subscription": {
+				Type:        schema.TypeInt,
+				Description: "The maximum number of domains to send to the API in a single subscription. When `domains` exceeds it, the resource automatically shards the domains (sorted, then split into consecutive chunks; no hash-based grouping is used) across multiple Fastly TLS subscriptions instead of sending one oversized batch; see `shard_subscription_ids`. Defaults to `0` (unlimited, single subscription).",
+				Optional:    true,
+				Default:     0,
+			},
 			"managed_dns_challenge": {
 				Type:        schema.TypeMap,
 				Description: "The details required to configure DNS to respond to ACME DNS challenge in order to verify domain ownership.",
@@ -138,6 +164,12 @@ func resourceFastlyTLSSubscription() *schema.Resource {
 					},
 				},
 			},
+			"shard_subscription_ids": {
+				Type:        schema.TypeList,
+				Description: "The IDs of the additional Fastly TLS subscriptions created to hold the domains beyond the first shard, when `domains` exceeds `max_domains_per_subscription`. The primary subscription's ID is this resource's `id`; `state`, `common_name` and `certificate_id` reflect the primary subscription only.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"state": {
 				Type:        schema.TypeString,
 				Description: "The current state of the subscription. The list of possible states are: `pending`, `processing`, `issued`, and `renewing`.",
@@ -160,33 +192,56 @@ func resourceFastlyTLSSubscriptionCreate(ctx context.Context, d *schema.Resource
 		configuration = &gofastly.TLSConfiguration{ID: v.(string)}
 	}
 
-	var domains []*gofastly.TLSDomain
 	var domainStrings []string
 	for _, domain := range d.Get("domains").(*schema.Set).List() {
-		domains = append(domains, &gofastly.TLSDomain{ID: domain.(string)})
 		domainStrings = append(domainStrings, domain.(string))
 	}
 
-	var commonName *gofastly.TLSDomain
+	commonName := ""
 	if v, ok := d.GetOk("common_name"); ok {
 		if !contains(domainStrings, v.(string)) {
 			return diag.Errorf("domain specified as common_name (%s) must also be in domains (%v)", v, domainStrings)
 		}
-
-		commonName = &gofastly.TLSDomain{ID: v.(string)}
+		commonName = v.(string)
 	}
 
-	subscription, err := conn.CreateTLSSubscription(&gofastly.CreateTLSSubscriptionInput{
-		CertificateAuthority: d.Get("certificate_authority").(string),
-		Configuration:        configuration,
-		Domains:              domains,
-		CommonName:           commonName,
-	})
+	shards, err := resourceFastlyTLSSubscriptionPlanShards(domainStrings, d.Get("max_domains_per_subscription").(int))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(subscription.ID)
+	var subscriptionIDs []string
+	for _, shardDomains := range shards {
+		var domains []*gofastly.TLSDomain
+		for _, domain := range shardDomains {
+			domains = append(domains, &gofastly.TLSDomain{ID: domain})
+		}
+
+		var shardCommonName *gofastly.TLSDomain
+		if commonName != "" && contains(shardDomains, commonName) {
+			shardCommonName = &gofastly.TLSDomain{ID: commonName}
+		}
+
+		subscription, err := conn.CreateTLSSubscription(&gofastly.CreateTLSSubscriptionInput{
+			CertificateAuthority: d.Get("certificate_authority").(string),
+			Configuration:        configuration,
+			Domains:              domains,
+			CommonName:           shardCommonName,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		subscriptionIDs = append(subscriptionIDs, subscription.ID)
+
+		// Persist each shard's ID as soon as it exists upstream. d.SetId makes
+		// Terraform keep this as tainted partial state even though we still
+		// return an error below if a later shard fails, so a shard we already
+		// created against the real API is never orphaned outside of state.
+		d.SetId(subscriptionIDs[0])
+		if err := d.Set("shard_subscription_ids", subscriptionIDs[1:]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	return resourceFastlyTLSSubscriptionRead(ctx, d, meta)
 }
@@ -229,25 +284,29 @@ func resourceFastlyTLSSubscriptionRead(_ context.Context, d *schema.ResourceData
 
 	var managedHTTPChallenges []map[string]any
 	var managedDNSChallenges []map[string]any
-	for _, domain := range subscription.Authorizations {
-		for _, challenge := range domain.Challenges {
-			if challenge.Type == "managed-dns" {
-				if len(challenge.Values) < 1 {
-					return diag.Errorf("fastly API returned no record values for Managed DNS Challenges")
-				}
+	var globalsignEmailChallenges []map[string]any
+	if err := resourceFastlyTLSSubscriptionAppendChallenges(subscription.Authorizations, &managedDNSChallenges, &managedHTTPChallenges, &globalsignEmailChallenges); err != nil {
+		return diag.FromErr(err)
+	}
 
-				managedDNSChallenges = append(managedDNSChallenges, map[string]any{
-					"record_type":  challenge.RecordType,
-					"record_name":  challenge.RecordName,
-					"record_value": challenge.Values[0],
-				})
-			} else {
-				managedHTTPChallenges = append(managedHTTPChallenges, map[string]any{
-					"record_type":   challenge.RecordType,
-					"record_name":   challenge.RecordName,
-					"record_values": challenge.Values,
-				})
-			}
+	// When domains were sharded across additional subscriptions (see
+	// max_domains_per_subscription), merge in their domains and challenge
+	// data too. Singular fields below (state, common_name, certificate_id,
+	// ...) still describe the primary subscription only - see
+	// shard_subscription_ids's doc comment.
+	for _, shardID := range resourceFastlyTLSSubscriptionShardIDs(d) {
+		shardSubscription, err := conn.GetTLSSubscription(&gofastly.GetTLSSubscriptionInput{
+			ID:      shardID,
+			Include: &include,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, shardDomain := range shardSubscription.Domains {
+			domains = append(domains, shardDomain.ID)
+		}
+		if err := resourceFastlyTLSSubscriptionAppendChallenges(shardSubscription.Authorizations, &managedDNSChallenges, &managedHTTPChallenges, &globalsignEmailChallenges); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -345,7 +404,53 @@ func resourceFastlyTLSSubscriptionRead(_ context.Context, d *schema.ResourceData
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	err = d.Set("globalsign_email_challenges", globalsignEmailChallenges)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceFastlyTLSSubscriptionAppendChallenges sorts one subscription's
+// authorizations into the three challenge buckets tracked on the resource,
+// appending to whatever the caller already collected. Shared between the
+// primary subscription and any shards it was split across.
+func resourceFastlyTLSSubscriptionAppendChallenges(authorizations []*gofastly.TLSAuthorizations, managedDNSChallenges, managedHTTPChallenges, globalsignEmailChallenges *[]map[string]any) error {
+	for _, authorization := range authorizations {
+		for _, challenge := range authorization.Challenges {
+			switch challenge.Type {
+			case "managed-dns":
+				if len(challenge.Values) < 1 {
+					return fmt.Errorf("fastly API returned no record values for Managed DNS Challenge")
+				}
+
+				*managedDNSChallenges = append(*managedDNSChallenges, map[string]any{
+					"record_type":  challenge.RecordType,
+					"record_name":  challenge.RecordName,
+					"record_value": challenge.Values[0],
+				})
+			case "globalsign-email":
+				// NOTE: the email address is only known once a recipient has been chosen
+				// and the confirmation email requested via fastly_tls_globalsign_email_challenge.
+				var emailAddress string
+				if len(challenge.Values) > 0 {
+					emailAddress = challenge.Values[0]
+				}
 
+				*globalsignEmailChallenges = append(*globalsignEmailChallenges, map[string]any{
+					"authorization_id": authorization.ID,
+					"email_address":    emailAddress,
+				})
+			default:
+				*managedHTTPChallenges = append(*managedHTTPChallenges, map[string]any{
+					"record_type":   challenge.RecordType,
+					"record_name":   challenge.RecordName,
+					"record_values": challenge.Values,
+				})
+			}
+		}
+	}
 	return nil
 }
 
@@ -357,36 +462,96 @@ func resourceFastlyTLSSubscriptionUpdate(ctx context.Context, d *schema.Resource
 	// "force_update" (which has no effect on the upstream data model).
 	//
 	// So we don't want to call the API if the customer neither passes a change to
-	// domains or to the common_name attributes as that would be a waste of
-	// network resources.
+	// domains, common_name or max_domains_per_subscription as that would be a
+	// waste of network resources.
 	//
 	// This is why we wrap the API request in the following conditional check.
 	// We then send BOTH "domains" and "common_name" in the API request.
 	// This is because they both will have a pre-existing value.
-	if d.HasChanges("domains", "common_name") {
-		// NOTE: The API doesn't care if the domains are in a different order.
-		// I mention this because if it did, then we'd only want to set the Domains
-		// field on the input struct if there was a change because we otherwise
-		// can't guarantee the order.
-		var domains []*gofastly.TLSDomain
+	if d.HasChanges("domains", "common_name", "max_domains_per_subscription") {
+		conn := meta.(*APIClient).conn
+
+		var domainStrings []string
 		for _, domain := range d.Get("domains").(*schema.Set).List() {
-			domains = append(domains, &gofastly.TLSDomain{ID: domain.(string)})
+			domainStrings = append(domainStrings, domain.(string))
+		}
+		commonName := d.Get("common_name").(string)
+
+		shards, err := resourceFastlyTLSSubscriptionPlanShards(domainStrings, d.Get("max_domains_per_subscription").(int))
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		updates := &gofastly.UpdateTLSSubscriptionInput{
-			ID:         d.Id(),
-			Force:      d.Get("force_update").(bool),
-			CommonName: &gofastly.TLSDomain{ID: d.Get("common_name").(string)},
-			Domains:    domains,
+		// Re-plan from scratch and reconcile against whatever shards already
+		// exist: PATCH the ones we can reuse (in place, so an unaffected shard
+		// keeps its ID and upstream state), create any additional ones the new
+		// plan needs, and delete any that are no longer needed. This keeps a
+		// domain-set change that still requires sharding from forcing a full
+		// delete/recreate of every shard - see resourceFastlyTLSSubscriptionIsStateImmutable
+		// for the cases (e.g. "processing" state) that still have to go through
+		// ForceNew.
+		existingIDs := append([]string{d.Id()}, resourceFastlyTLSSubscriptionShardIDs(d)...)
+		reuseCount, staleIDs := resourceFastlyTLSSubscriptionShardPlan(existingIDs, len(shards))
+		force := d.Get("force_update").(bool)
+		configuration := &gofastly.TLSConfiguration{ID: d.Get("configuration_id").(string)}
+
+		var subscriptionIDs []string
+		for i, shardDomains := range shards {
+			var domains []*gofastly.TLSDomain
+			for _, domain := range shardDomains {
+				domains = append(domains, &gofastly.TLSDomain{ID: domain})
+			}
+			var shardCommonName *gofastly.TLSDomain
+			if commonName != "" && contains(shardDomains, commonName) {
+				shardCommonName = &gofastly.TLSDomain{ID: commonName}
+			}
+
+			if i < reuseCount {
+				// NOTE: The API doesn't care if the domains are in a different
+				// order, and we always send both "domains" and "common_name"
+				// since an existing shard will have a pre-existing value for
+				// each.
+				_, err := conn.UpdateTLSSubscription(&gofastly.UpdateTLSSubscriptionInput{
+					ID:            existingIDs[i],
+					Force:         force,
+					CommonName:    shardCommonName,
+					Domains:       domains,
+					Configuration: configuration,
+				})
+				if err != nil {
+					return resourceFastlyTLSSubscriptionUpdateDiagnostics(d, err)
+				}
+				subscriptionIDs = append(subscriptionIDs, existingIDs[i])
+			} else {
+				subscription, err := conn.CreateTLSSubscription(&gofastly.CreateTLSSubscriptionInput{
+					CertificateAuthority: d.Get("certificate_authority").(string),
+					Configuration:        configuration,
+					Domains:              domains,
+					CommonName:           shardCommonName,
+				})
+				if err != nil {
+					return resourceFastlyTLSSubscriptionUpdateDiagnostics(d, err)
+				}
+				subscriptionIDs = append(subscriptionIDs, subscription.ID)
+			}
 
-			// IMPORTANT: We should always pass the configuration_id to the API.
-			Configuration: &gofastly.TLSConfiguration{ID: d.Get("configuration_id").(string)},
+			// Persist the shard IDs reconciled so far immediately, same as
+			// Create: if a later shard fails, the ones already
+			// updated/created against the real API stay represented in
+			// state instead of leaking as untracked orphans.
+			d.SetId(subscriptionIDs[0])
+			if err := d.Set("shard_subscription_ids", subscriptionIDs[1:]); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 
-		conn := meta.(*APIClient).conn
-		_, err := conn.UpdateTLSSubscription(updates)
-		if err != nil {
-			return diag.FromErr(err)
+		// Any shard from before this update that the new plan no longer needs.
+		// State above already reflects the final shard set, so nothing more
+		// to persist once these deletes succeed.
+		for _, staleID := range staleIDs {
+			if err := conn.DeleteTLSSubscription(&gofastly.DeleteTLSSubscriptionInput{ID: staleID, Force: force}); err != nil {
+				return resourceFastlyTLSSubscriptionUpdateDiagnostics(d, err)
+			}
 		}
 	}
 
@@ -394,14 +559,78 @@ func resourceFastlyTLSSubscriptionUpdate(ctx context.Context, d *schema.Resource
 	return resourceFastlyTLSSubscriptionRead(ctx, d, meta)
 }
 
+// resourceFastlyTLSSubscriptionUpdateDiagnostics turns a failed batch update
+// into a diagnostic targeted at whichever domain the API error actually names,
+// so the plan highlights the offending SAN instead of the whole "domains" set.
+// Falls back to a single generic diagnostic when the error text doesn't
+// mention any domain from the plan (old or new), since we'd otherwise be
+// guessing at a cause we can't substantiate.
+func resourceFastlyTLSSubscriptionUpdateDiagnostics(d *schema.ResourceData, err error) diag.Diagnostics {
+	oldDomains, newDomains := d.GetChange("domains")
+	all := oldDomains.(*schema.Set).Union(newDomains.(*schema.Set))
+
+	var diags diag.Diagnostics
+	for _, domain := range all.List() {
+		if !strings.Contains(err.Error(), domain.(string)) {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("failed to update TLS subscription: %s", err),
+			Detail:   fmt.Sprintf("the API error references domain %q", domain),
+			AttributePath: cty.Path{
+				cty.GetAttrStep{Name: "domains"},
+				cty.IndexStep{Key: cty.StringVal(domain.(string))},
+			},
+		})
+	}
+	if len(diags) == 0 {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
 func resourceFastlyTLSSubscriptionDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*APIClient).conn
 
-	err := conn.DeleteTLSSubscription(&gofastly.DeleteTLSSubscriptionInput{
-		ID:    d.Id(),
-		Force: d.Get("force_destroy").(bool),
-	})
-	return diag.FromErr(err)
+	force := d.Get("force_destroy").(bool)
+	ids := append([]string{d.Id()}, resourceFastlyTLSSubscriptionShardIDs(d)...)
+
+	for _, id := range ids {
+		err := conn.DeleteTLSSubscription(&gofastly.DeleteTLSSubscriptionInput{
+			ID:    id,
+			Force: force,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceFastlyTLSSubscriptionShardIDs(d *schema.ResourceData) []string {
+	var ids []string
+	for _, raw := range d.Get("shard_subscription_ids").([]any) {
+		ids = append(ids, raw.(string))
+	}
+	return ids
+}
+
+// resourceFastlyTLSSubscriptionShardPlan reconciles a newly-planned shard
+// count against the subscription IDs already in state. The first reuseCount
+// shards of the new plan should PATCH existingIDs[0:reuseCount] in place;
+// any remaining shards beyond that need a new subscription created; any
+// existingIDs beyond shardCount are no longer needed and should be deleted.
+func resourceFastlyTLSSubscriptionShardPlan(existingIDs []string, shardCount int) (reuseCount int, staleIDs []string) {
+	reuseCount = len(existingIDs)
+	if reuseCount > shardCount {
+		reuseCount = shardCount
+	}
+	if len(existingIDs) > shardCount {
+		staleIDs = existingIDs[shardCount:]
+	}
+	return reuseCount, staleIDs
 }
 
 func resourceFastlyTLSSubscriptionIsStateImmutable(_ context.Context, d *schema.ResourceDiff, _ any) bool {
@@ -442,3 +671,36 @@ func resourceFastlyTLSSubscriptionValidateCommonName(_ context.Context, v, _ any
 	}
 	return nil
 }
+
+// resourceFastlyTLSSubscriptionPlanShards groups domains into ordered batches
+// of at most maxDomains domains each, suitable for one fastly_tls_subscription
+// per batch. Returns a single shard containing every domain when maxDomains
+// is unset (<= 0) or the domain count doesn't exceed it.
+func resourceFastlyTLSSubscriptionPlanShards(domains []string, maxDomains int) ([][]string, error) {
+	if maxDomains <= 0 || len(domains) <= maxDomains {
+		return [][]string{domains}, nil
+	}
+	return resourceFastlyTLSSubscriptionShardDomains(domains, maxDomains), nil
+}
+
+// resourceFastlyTLSSubscriptionShardDomains groups domains into deterministic
+// shards of at most shardSize domains each: sort the full domain set, then
+// slice it into contiguous chunks in order. Sorting first means the plan only
+// depends on the current domain set, not on shard/map iteration order, so the
+// same domains always produce the same shards.
+func resourceFastlyTLSSubscriptionShardDomains(domains []string, shardSize int) [][]string {
+	sorted := make([]string, len(domains))
+	copy(sorted, domains)
+	sort.Strings(sorted)
+
+	var shards [][]string
+	for i := 0; i < len(sorted); i += shardSize {
+		end := i + shardSize
+		if end > len(sorted) {
+			end = len(sorted)
+		}
+		shards = append(shards, sorted[i:end])
+	}
+
+	return shards
+}
@@ -0,0 +1,265 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v10/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceFastlyTLSSubscriptionDNSSolver() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFastlyTLSSubscriptionDNSSolverCreate,
+		ReadContext:   resourceFastlyTLSSubscriptionDNSSolverRead,
+		DeleteContext: resourceFastlyTLSSubscriptionDNSSolverDelete,
+		Schema: map[string]*schema.Schema{
+			"config": {
+				Type:        schema.TypeMap,
+				Description: "Provider-specific configuration. For `webhook` this accepts `url` (required): the HTTP endpoint to PUT/DELETE challenge records to.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"dns_provider": {
+				Type:         schema.TypeString,
+				Description:  "The DNS backend to publish the ACME DNS-01 challenge records to. Currently only `webhook` is supported - there is no native Route53, Cloudflare, Azure DNS or Google Cloud DNS integration; point `config.url` at a small endpoint of your own in front of whichever provider's API you need.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"webhook"}, false),
+			},
+			"propagation_timeout": {
+				Type:        schema.TypeString,
+				Description: "How long to wait for the published records to be visible on the domain's authoritative nameservers, expressed as a Go duration string. Defaults to `10m`.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "10m",
+			},
+			"records": {
+				Type:        schema.TypeSet,
+				Description: "The DNS records that were published for this subscription, recorded so they can be cleaned up on destroy.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"record_name":  {Type: schema.TypeString, Computed: true},
+						"record_type":  {Type: schema.TypeString, Computed: true},
+						"record_value": {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the TLS subscription whose `managed_dns_challenges` should be solved automatically.",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceFastlyTLSSubscriptionDNSSolverCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	subscriptionID := d.Get("subscription_id").(string)
+	timeout, err := time.ParseDuration(d.Get("propagation_timeout").(string))
+	if err != nil {
+		return diag.Errorf("invalid propagation_timeout %q: %s", d.Get("propagation_timeout").(string), err)
+	}
+
+	config := make(map[string]string)
+	for k, v := range d.Get("config").(map[string]any) {
+		config[k] = v.(string)
+	}
+
+	provider, err := newDNSChallengeProvider(ctx, d.Get("dns_provider").(string), config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	include := "tls_authorizations"
+	subscription, err := conn.GetTLSSubscription(&gofastly.GetTLSSubscriptionInput{ID: subscriptionID, Include: &include})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var records []dnsChallengeRecord
+	for _, authorization := range subscription.Authorizations {
+		for _, challenge := range authorization.Challenges {
+			if challenge.Type != "managed-dns" {
+				continue
+			}
+			if len(challenge.Values) < 1 {
+				return diag.Errorf("fastly API returned no record values for Managed DNS Challenge on authorization %s", authorization.ID)
+			}
+
+			record := dnsChallengeRecord{
+				RecordName:  challenge.RecordName,
+				RecordType:  challenge.RecordType,
+				RecordValue: challenge.Values[0],
+			}
+			if err := provider.Present(ctx, record); err != nil {
+				return diag.Errorf("failed to publish DNS record %s for authorization %s: %s", record.RecordName, authorization.ID, err)
+			}
+			records = append(records, record)
+		}
+	}
+
+	deadline := time.Now().Add(timeout)
+	for _, record := range records {
+		if err := waitForDNSPropagation(ctx, record, deadline); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// go-fastly doesn't expose a way to force an immediate re-check; Fastly
+	// polls pending managed-dns authorizations on its own schedule once the
+	// records are live, so there is nothing further to trigger here. Callers
+	// that need to block until the certificate is issued should depend on
+	// fastly_tls_subscription_validation as well.
+	d.SetId(subscriptionID)
+
+	recordMaps := make([]map[string]any, 0, len(records))
+	for _, record := range records {
+		recordMaps = append(recordMaps, map[string]any{
+			"record_name":  record.RecordName,
+			"record_type":  record.RecordType,
+			"record_value": record.RecordValue,
+		})
+	}
+	if err := d.Set("records", recordMaps); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceFastlyTLSSubscriptionDNSSolverRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	_, err := conn.GetTLSSubscription(&gofastly.GetTLSSubscriptionInput{ID: d.Id()})
+	if err, ok := err.(*gofastly.HTTPError); ok && err.IsNotFound() {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceFastlyTLSSubscriptionDNSSolverDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := make(map[string]string)
+	for k, v := range d.Get("config").(map[string]any) {
+		config[k] = v.(string)
+	}
+
+	provider, err := newDNSChallengeProvider(ctx, d.Get("dns_provider").(string), config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, raw := range d.Get("records").(*schema.Set).List() {
+		r := raw.(map[string]any)
+		record := dnsChallengeRecord{
+			RecordName:  r["record_name"].(string),
+			RecordType:  r["record_type"].(string),
+			RecordValue: r["record_value"].(string),
+		}
+		if err := provider.CleanUp(ctx, record); err != nil {
+			return diag.Errorf("failed to remove DNS record %s: %s", record.RecordName, err)
+		}
+	}
+
+	return nil
+}
+
+// waitForDNSPropagation polls the domain's authoritative nameservers directly
+// (rather than relying on a possibly-cached recursive resolver) until the
+// challenge record is visible everywhere, or deadline is reached.
+func waitForDNSPropagation(ctx context.Context, record dnsChallengeRecord, deadline time.Time) error {
+	nameservers, err := authoritativeNameservers(record.RecordName)
+	if err != nil {
+		return fmt.Errorf("unable to determine authoritative nameservers for %s: %w", record.RecordName, err)
+	}
+
+	for {
+		allPropagated := true
+		for _, ns := range nameservers {
+			ok, err := nameserverHasRecord(ctx, ns, record)
+			if err != nil || !ok {
+				allPropagated = false
+				break
+			}
+		}
+		if allPropagated {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for DNS record %s to propagate to %v", record.RecordName, nameservers)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+func authoritativeNameservers(domain string) ([]string, error) {
+	zone := strings.TrimSuffix(domain, ".")
+	for {
+		nss, err := net.LookupNS(zone)
+		if err == nil && len(nss) > 0 {
+			hosts := make([]string, len(nss))
+			for i, ns := range nss {
+				hosts[i] = ns.Host
+			}
+			return hosts, nil
+		}
+
+		idx := strings.Index(zone, ".")
+		if idx == -1 {
+			return nil, fmt.Errorf("no nameservers found for %s", domain)
+		}
+		zone = zone[idx+1:]
+	}
+}
+
+func nameserverHasRecord(ctx context.Context, nameserver string, record dnsChallengeRecord) (bool, error) {
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			d := net.Dialer{Timeout: 5 * time.Second}
+			return d.DialContext(ctx, network, net.JoinHostPort(nameserver, "53"))
+		},
+	}
+
+	// Fastly's managed-dns challenges are published as CNAME records
+	// (record_value points at a *.fastly-validations.com target); TXT is kept
+	// as a fallback for any future challenge type that uses it.
+	if strings.EqualFold(record.RecordType, "CNAME") {
+		target, err := resolver.LookupCNAME(ctx, record.RecordName)
+		if err != nil {
+			return false, nil
+		}
+		return strings.EqualFold(strings.TrimSuffix(target, "."), strings.TrimSuffix(record.RecordValue, ".")), nil
+	}
+
+	values, err := resolver.LookupTXT(ctx, record.RecordName)
+	if err != nil {
+		return false, nil
+	}
+	for _, v := range values {
+		if v == record.RecordValue {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,115 @@
+package fastly
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResourceFastlyTLSSubscriptionPlanShards(t *testing.T) {
+	domains := []string{"e.com", "a.com", "d.com", "b.com", "c.com"}
+
+	cases := map[string]struct {
+		domains    []string
+		maxDomains int
+		want       [][]string
+	}{
+		"unlimited": {
+			domains:    domains,
+			maxDomains: 0,
+			want:       [][]string{domains},
+		},
+		"under the limit": {
+			domains:    domains,
+			maxDomains: 10,
+			want:       [][]string{domains},
+		},
+		"exactly at the limit": {
+			// At (not over) the limit, domains short-circuits to a single
+			// shard without going through the sort+chunk path.
+			domains:    domains,
+			maxDomains: 5,
+			want:       [][]string{domains},
+		},
+		"one over the limit": {
+			domains:    domains,
+			maxDomains: 4,
+			want: [][]string{
+				{"a.com", "b.com", "c.com", "d.com"},
+				{"e.com"},
+			},
+		},
+		"splits into shards of at most maxDomains": {
+			domains:    domains,
+			maxDomains: 2,
+			want: [][]string{
+				{"a.com", "b.com"},
+				{"c.com", "d.com"},
+				{"e.com"},
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := resourceFastlyTLSSubscriptionPlanShards(c.domains, c.maxDomains)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestResourceFastlyTLSSubscriptionShardPlan(t *testing.T) {
+	cases := map[string]struct {
+		existingIDs  []string
+		shardCount   int
+		wantReuse    int
+		wantStaleIDs []string
+	}{
+		"no existing shards, plan needs one": {
+			existingIDs:  nil,
+			shardCount:   1,
+			wantReuse:    0,
+			wantStaleIDs: nil,
+		},
+		"existing shards match the new plan exactly": {
+			existingIDs:  []string{"a", "b", "c"},
+			shardCount:   3,
+			wantReuse:    3,
+			wantStaleIDs: nil,
+		},
+		"plan grew, reuse all existing and create the rest": {
+			existingIDs:  []string{"a", "b"},
+			shardCount:   4,
+			wantReuse:    2,
+			wantStaleIDs: nil,
+		},
+		"plan shrank, reuse the first shards and drop the rest": {
+			existingIDs:  []string{"a", "b", "c", "d"},
+			shardCount:   2,
+			wantReuse:    2,
+			wantStaleIDs: []string{"c", "d"},
+		},
+		"plan shrank to nothing reused": {
+			existingIDs:  []string{"a", "b"},
+			shardCount:   0,
+			wantReuse:    0,
+			wantStaleIDs: []string{"a", "b"},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotReuse, gotStaleIDs := resourceFastlyTLSSubscriptionShardPlan(c.existingIDs, c.shardCount)
+			if gotReuse != c.wantReuse {
+				t.Errorf("reuseCount: got %d, want %d", gotReuse, c.wantReuse)
+			}
+			if !reflect.DeepEqual(gotStaleIDs, c.wantStaleIDs) {
+				t.Errorf("staleIDs: got %v, want %v", gotStaleIDs, c.wantStaleIDs)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v10/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceFastlyTLSSubscriptionValidation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFastlyTLSSubscriptionValidationCreate,
+		ReadContext:   resourceFastlyTLSSubscriptionValidationRead,
+		DeleteContext: resourceFastlyTLSSubscriptionValidationDelete,
+		Schema: map[string]*schema.Schema{
+			"certificate_id": {
+				Type:        schema.TypeString,
+				Description: "The certificate ID associated with the subscription once it has been issued.",
+				Computed:    true,
+			},
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the TLS subscription to wait on.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"timeout": {
+				Type:        schema.TypeString,
+				Description: "The maximum amount of time to wait for the subscription to reach the `issued` state, expressed as a Go duration string (e.g. `45m`). Defaults to `45m`.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "45m",
+			},
+		},
+	}
+}
+
+func resourceFastlyTLSSubscriptionValidationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	subscriptionID := d.Get("subscription_id").(string)
+
+	timeout, err := time.ParseDuration(d.Get("timeout").(string))
+	if err != nil {
+		return diag.Errorf("invalid timeout %q: %s", d.Get("timeout").(string), err)
+	}
+
+	deadline := time.Now().Add(timeout)
+	backoff := 5 * time.Second
+	const maxBackoff = 30 * time.Second
+
+	for {
+		subscription, err := conn.GetTLSSubscription(&gofastly.GetTLSSubscriptionInput{ID: subscriptionID})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if subscription.State == "issued" {
+			d.SetId(subscriptionID)
+
+			certificateID := ""
+			if len(subscription.Certificates) > 0 {
+				certificateID = subscription.Certificates[0].ID
+			}
+			if err := d.Set("certificate_id", certificateID); err != nil {
+				return diag.FromErr(err)
+			}
+
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return diag.Errorf("timed out after %s waiting for TLS subscription %s to be issued (last state: %s)", timeout, subscriptionID, subscription.State)
+		}
+
+		select {
+		case <-ctx.Done():
+			return diag.FromErr(ctx.Err())
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+func resourceFastlyTLSSubscriptionValidationRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*APIClient).conn
+
+	subscription, err := conn.GetTLSSubscription(&gofastly.GetTLSSubscriptionInput{ID: d.Id()})
+	if err, ok := err.(*gofastly.HTTPError); ok && err.IsNotFound() {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// "renewing" is a normal, transient state a subscription passes through
+	// on every certificate rotation - it must not be treated as a loss of the
+	// issued certificate, or this resource would be destroyed and recreated
+	// (and dependents re-blocked) on every renewal.
+	if subscription.State != "issued" && subscription.State != "renewing" {
+		id := d.Id()
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("TLS subscription (%s) is no longer issued (state: %s) - removing validation from state", id, subscription.State),
+			},
+		}
+	}
+
+	certificateID := ""
+	if len(subscription.Certificates) > 0 {
+		certificateID = subscription.Certificates[0].ID
+	}
+
+	if err := d.Set("subscription_id", subscription.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("certificate_id", certificateID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceFastlyTLSSubscriptionValidationDelete(_ context.Context, _ *schema.ResourceData, _ any) diag.Diagnostics {
+	// NOTE: this resource only observes the subscription's state, it doesn't
+	// own any upstream object, so there is nothing to clean up on delete.
+	return nil
+}